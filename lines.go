@@ -0,0 +1,30 @@
+package jsontokenizer
+
+import "io"
+
+// LineTokenizer wraps a Tokenizer to stream NDJSON / JSON Lines input:
+// a sequence of top-level JSON values that are either newline-delimited
+// or directly concatenated, such as log pipelines or LLM/SSE output.
+type LineTokenizer struct {
+	Tokenizer
+}
+
+// NewLines returns a LineTokenizer reading newline-delimited or
+// concatenated top-level JSON values from in.
+func NewLines(in io.Reader) *LineTokenizer {
+	return &LineTokenizer{Tokenizer: New(in)}
+}
+
+// NextValue returns the next token exactly like Token does. Once the
+// previous top-level value has been fully consumed, that token is the
+// first token of the next top-level value. NextValue does not track
+// nesting, so it does not skip the remainder of a partly read value: it
+// returns the next token inside it. It returns io.EOF once the input is
+// exhausted. Any error returned while consuming the rest of a value
+// (via ReadString, ReadNumber, or further Token calls) always
+// indicates a real problem, never end of stream, even if it happens to
+// be io.EOF itself (a value that stops mid-way through). To discard a
+// whole value without inspecting it, call Skip instead of NextValue.
+func (l *LineTokenizer) NextValue() (TokType, error) {
+	return l.Token()
+}
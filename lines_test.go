@@ -0,0 +1,109 @@
+package jsontokenizer
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+// consumeValue finishes reading the value whose first token (already
+// consumed from lt) is tok, discarding its contents.
+func consumeValue(lt *LineTokenizer, tok TokType) error {
+	switch tok {
+	case TokString:
+		_, err := lt.ReadString(io.Discard)
+		return err
+	case TokNumber:
+		_, err := lt.ReadNumber(io.Discard)
+		return err
+	case TokArrayOpen, TokObjectOpen:
+		depth := 1
+		for depth > 0 {
+			tok, err := lt.Token()
+			if err != nil {
+				return err
+			}
+			switch tok {
+			case TokString:
+				if _, err := lt.ReadString(io.Discard); err != nil {
+					return err
+				}
+			case TokNumber:
+				if _, err := lt.ReadNumber(io.Discard); err != nil {
+					return err
+				}
+			case TokArrayOpen, TokObjectOpen:
+				depth++
+			case TokArrayClose, TokObjectClose:
+				depth--
+			}
+		}
+	}
+	return nil
+}
+
+func TestLineTokenizer(t *testing.T) {
+	tts := []struct {
+		in   string
+		toks []TokType
+	}{
+		{`{"a":1}{"b":2}`, []TokType{TokObjectOpen, TokObjectOpen}},
+		{"{\"a\":1}\n{\"b\":2}\n", []TokType{TokObjectOpen, TokObjectOpen}},
+		{"null true false", []TokType{TokNull, TokTrue, TokFalse}},
+		{`"a" "b" "c"`, []TokType{TokString, TokString, TokString}},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is   = is.New(t)
+				lt   = NewLines(bytes.NewBufferString(tt.in))
+				toks []TokType
+			)
+			for {
+				tok, err := lt.NextValue()
+				if err == io.EOF {
+					break
+				}
+				is.NoErr(err)
+				toks = append(toks, tok)
+				is.NoErr(consumeValue(lt, tok))
+			}
+			is.Equal(toks, tt.toks)
+		})
+	}
+}
+
+func TestLineTokenizer_SkipsWholeValues(t *testing.T) {
+	var (
+		is = is.New(t)
+		lt = NewLines(bytes.NewBufferString(`{"a":1} {"b":[1,2,3]} 42`))
+		n  int
+	)
+	for {
+		if err := lt.Skip(); err != nil {
+			is.Equal(err, io.EOF)
+			break
+		}
+		n++
+	}
+	is.Equal(n, 3)
+}
+
+func TestLineTokenizer_MidValueEOF(t *testing.T) {
+	var (
+		is = is.New(t)
+		lt = NewLines(bytes.NewBufferString(`{"a":1}{"b":`))
+	)
+
+	tok, err := lt.NextValue()
+	is.NoErr(err)
+	is.Equal(tok, TokObjectOpen)
+	is.NoErr(consumeValue(lt, tok))
+
+	tok, err = lt.NextValue()
+	is.NoErr(err)
+	is.Equal(tok, TokObjectOpen)
+	is.True(consumeValue(lt, tok) != nil)
+}
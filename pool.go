@@ -0,0 +1,66 @@
+package jsontokenizer
+
+import (
+	"io"
+	"sync"
+)
+
+// Pool manages a reusable set of Tokenizer values backed by a
+// sync.Pool, so hot loops that tokenize many short-lived JSON
+// messages (e.g. one per HTTP request) don't allocate a new buffer
+// every time.
+type Pool struct {
+	size int
+	pool sync.Pool
+}
+
+// NewPool returns a Pool whose Tokenizer values use the given buffer
+// size.
+func NewPool(size int) *Pool {
+	p := &Pool{size: size}
+	p.pool.New = func() interface{} {
+		return &tokenizer{buf: make([]byte, p.size)}
+	}
+	return p
+}
+
+// Get returns a Tokenizer from the pool, reading from in. Return it to
+// the pool with Put when done with it.
+func (p *Pool) Get(in io.Reader) Tokenizer {
+	t := p.pool.Get().(*tokenizer)
+	t.Reset(in)
+	return t
+}
+
+// Put returns t, previously obtained from Get, to the pool for reuse.
+// t must not be used again after Put, and any slice previously
+// borrowed from its Token, ReadString, or ReadStringUnescaped calls
+// must no longer be in use, since a future Get may reuse the same
+// underlying buffer.
+func (p *Pool) Put(t Tokenizer) {
+	tk, ok := t.(*tokenizer)
+	if !ok {
+		return
+	}
+	tk.Reset(nil)
+	p.pool.Put(tk)
+}
+
+var defaultPool = NewPool(defaultSize)
+
+// NewFromPool returns a Tokenizer with default buffer size pulled from
+// an internal pool, reading from in. Release it back to the pool with
+// Release when done with it to avoid allocating a new buffer next
+// time.
+func NewFromPool(in io.Reader) Tokenizer {
+	return defaultPool.Get(in)
+}
+
+// Release returns t, previously obtained from NewFromPool, to the
+// internal pool. t must not be used again after Release, and any
+// slice previously borrowed from its Token, ReadString, or
+// ReadStringUnescaped calls must no longer be in use, since a future
+// NewFromPool may reuse the same underlying buffer.
+func Release(t Tokenizer) {
+	defaultPool.Put(t)
+}
@@ -0,0 +1,80 @@
+package jsontokenizer
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestPool(t *testing.T) {
+	var (
+		is = is.New(t)
+		p  = NewPool(7)
+		tk = p.Get(bytes.NewBufferString(`"hi"`))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokString)
+	var buf bytes.Buffer
+	_, err = tk.ReadString(&buf)
+	is.NoErr(err)
+	is.Equal(buf.String(), "hi")
+	p.Put(tk)
+
+	tk2 := p.Get(bytes.NewBufferString(`42`))
+	tok, err = tk2.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNumber)
+	n, err := tk2.ReadInt64()
+	is.NoErr(err)
+	is.Equal(n, int64(42))
+}
+
+func TestPool_StrictDoesNotLeakAcrossPut(t *testing.T) {
+	var (
+		is = is.New(t)
+		p  = NewPool(7)
+		tk = p.Get(bytes.NewBufferString(`{"a":1,}`))
+	)
+	tk.SetStrict(true)
+	_, err := tk.Token()
+	is.NoErr(err)
+	p.Put(tk)
+
+	tk2 := p.Get(bytes.NewBufferString(`{"a":1,}`))
+	for {
+		tok, err := tk2.Token()
+		if err == io.EOF {
+			break
+		}
+		is.NoErr(err)
+		switch tok {
+		case TokString:
+			_, err = tk2.ReadString(io.Discard)
+			is.NoErr(err)
+		case TokNumber:
+			_, err = tk2.ReadNumber(io.Discard)
+			is.NoErr(err)
+		}
+	}
+}
+
+func TestNewFromPool(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = NewFromPool(bytes.NewBufferString("null"))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNull)
+	Release(tk)
+
+	tk2 := NewFromPool(bytes.NewBufferString("true"))
+	tok, err = tk2.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokTrue)
+	_, err = tk2.Token()
+	is.Equal(err, io.EOF)
+}
@@ -1,10 +1,20 @@
 package jsontokenizer
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
+// ErrNotInteger is returned by ReadInt64 and ReadUint64 when the
+// current number token is syntactically a float (it contains a '.',
+// 'e', or 'E'), so callers can fall back to ReadFloat64.
+var ErrNotInteger = errors.New("jsontokenizer: number is a float, not an integer")
+
 // A TokType is an enum for JSON types.
 type TokType int
 
@@ -25,15 +35,101 @@ const (
 	defaultSize = 64
 )
 
+// String returns a short human-readable name for the token type, used
+// in grammar error messages from strict mode.
+func (tok TokType) String() string {
+	switch tok {
+	case TokNull:
+		return "null"
+	case TokTrue:
+		return "true"
+	case TokFalse:
+		return "false"
+	case TokNumber:
+		return "number"
+	case TokString:
+		return "string"
+	case TokArrayOpen:
+		return "["
+	case TokArrayClose:
+		return "]"
+	case TokObjectOpen:
+		return "{"
+	case TokObjectClose:
+		return "}"
+	case TokObjectColon:
+		return ":"
+	case TokComma:
+		return ","
+	default:
+		return "unknown token"
+	}
+}
+
+// Grammar frame states used by strict mode to track where in an
+// object or array the next token is expected to land.
+const (
+	stateArrStart byte = iota // just opened '[': value or ']'
+	stateArrValue             // after ',': value required, ']' not allowed
+	stateArrComma             // after a value: ',' or ']'
+	stateObjStart             // just opened '{': string key or '}'
+	stateObjKey               // after ',': string key required, '}' not allowed
+	stateObjColon             // after a key: ':' required
+	stateObjValue             // after ':': value required
+	stateObjComma             // after a value: ',' or '}'
+)
+
 // Tokenizer reads and tokenizes JSON from an input stream.
 type Tokenizer interface {
 	// Token returns next token. TokString and TokNumber tokens must be
-	// consumed by ReadString and ReadNumber respectively.
+	// consumed by ReadString and ReadNumber respectively. When strict
+	// mode is enabled (see SetStrict), Token also enforces the JSON
+	// grammar from RFC 8259 and returns an error describing the
+	// offending byte offset on violation.
 	Token() (TokType, error)
+	// Peek returns the type of the next token without consuming it, so
+	// a later call to Token returns the same type and performs the
+	// actual consumption.
+	Peek() (TokType, error)
+	// Offset returns the number of bytes consumed from the underlying
+	// reader up to (but not including) the next token.
+	Offset() int64
 	// ReadNumber consumes number token by writing it into provided io.Writer.
 	ReadNumber(into io.Writer) (n int, err error)
+	// ReadInt64 consumes the current number token and parses it as an
+	// int64. It returns ErrNotInteger if the number is syntactically a
+	// float.
+	ReadInt64() (int64, error)
+	// ReadUint64 consumes the current number token and parses it as a
+	// uint64. It returns ErrNotInteger if the number is syntactically a
+	// float, and an error if it is negative.
+	ReadUint64() (uint64, error)
+	// ReadFloat64 consumes the current number token and parses it as a
+	// float64.
+	ReadFloat64() (float64, error)
 	// ReadString consumes string token by writing it into provided io.Writer.
 	ReadString(into io.Writer) (n int, err error)
+	// ReadStringUnescaped consumes a string token like ReadString, but
+	// decodes JSON escape sequences (\", \\, \/, \b, \f, \n, \r, \t and
+	// \uXXXX, including UTF-16 surrogate pairs) before writing the
+	// resulting UTF-8 bytes into the provided io.Writer.
+	ReadStringUnescaped(into io.Writer) (n int, err error)
+	// Skip consumes the next JSON value at the current position and
+	// discards it. The value may be a scalar or an entire object/array
+	// subtree; nested strings are scanned for matching quotes so that
+	// brackets inside them are ignored.
+	Skip() error
+	// SetStrict enables or disables strict grammar validation. It also
+	// resets any in-progress grammar state, so it is typically called
+	// once, right after construction.
+	SetStrict(strict bool)
+	// Depth returns the current container nesting depth. It is only
+	// meaningful when strict mode is enabled.
+	Depth() int
+	// InObject reports whether the tokenizer is currently positioned
+	// inside an object, as opposed to an array or the top level. It is
+	// only meaningful when strict mode is enabled.
+	InObject() bool
 	// Reset resets state of Tokenizer so it can be re-used with another Reader.
 	Reset(in io.Reader)
 }
@@ -63,10 +159,15 @@ var (
 )
 
 type tokenizer struct {
-	in   io.Reader
-	buf  []byte
-	bufp int
-	bufe int
+	in       io.Reader
+	buf      []byte
+	bufp     int
+	bufe     int
+	consumed int64
+
+	strict  bool
+	stack   []byte
+	topDone bool
 }
 
 // New returns a new Tokenizer with default buffer size.
@@ -79,7 +180,36 @@ func NewWithSize(in io.Reader, size int) Tokenizer {
 	return &tokenizer{in: in, buf: make([]byte, size)}
 }
 
+// NewStrict returns a new Tokenizer with default buffer size and
+// strict grammar validation enabled. See Tokenizer.SetStrict.
+func NewStrict(in io.Reader) Tokenizer {
+	t := &tokenizer{in: in, buf: make([]byte, defaultSize)}
+	t.strict = true
+	return t
+}
+
 func (t *tokenizer) Token() (TokType, error) {
+	tok, err := t.lex()
+	if err != nil {
+		if t.strict && err == io.EOF && len(t.stack) != 0 {
+			return tok, fmt.Errorf("unexpected EOF with unclosed %s at byte offset %d", t.unclosed(), t.offset())
+		}
+		if t.strict && err == io.EOF && !t.topDone {
+			return tok, fmt.Errorf("unexpected EOF before top-level value at byte offset %d", t.offset())
+		}
+		return tok, err
+	}
+
+	if t.strict {
+		if err := t.checkGrammar(tok); err != nil {
+			return tok, err
+		}
+	}
+
+	return tok, nil
+}
+
+func (t *tokenizer) lex() (TokType, error) {
 	c, err := t.peek()
 	if err != nil {
 		return TokNull, err
@@ -95,7 +225,7 @@ func (t *tokenizer) Token() (TokType, error) {
 		case 'n':
 			return TokNull, t.readWord(bnull)
 		default:
-			return TokNull, fmt.Errorf("invalid json %q", t.buf[t.bufp:t.bufe])
+			return TokNull, fmt.Errorf("invalid json %q at byte offset %d", t.buf[t.bufp:t.bufe], t.offset())
 		}
 	case TokObjectOpen, TokObjectClose, TokArrayOpen, TokArrayClose, TokObjectColon, TokComma:
 		t.bufp++
@@ -105,6 +235,179 @@ func (t *tokenizer) Token() (TokType, error) {
 	}
 }
 
+// Peek returns the type of the next token without consuming it. The
+// underlying byte(s) are left in the buffer for the following Token
+// call to consume as usual.
+func (t *tokenizer) Peek() (TokType, error) {
+	c, err := t.peek()
+	if err != nil {
+		return TokNull, err
+	}
+
+	switch toklookup[c] {
+	case 0:
+		switch c {
+		case 't':
+			return TokTrue, nil
+		case 'f':
+			return TokFalse, nil
+		case 'n':
+			return TokNull, nil
+		default:
+			return TokNull, fmt.Errorf("invalid json %q at byte offset %d", t.buf[t.bufp:t.bufe], t.offset())
+		}
+	default:
+		return toklookup[c], nil
+	}
+}
+
+// Offset returns the number of bytes consumed from the underlying
+// reader up to (but not including) the next token.
+func (t *tokenizer) Offset() int64 {
+	return t.offset()
+}
+
+// unclosed returns "object" or "array" depending on the innermost open
+// container on t.stack, for use in the strict-mode unexpected-EOF
+// error. It is only meaningful when the stack is non-empty.
+func (t *tokenizer) unclosed() string {
+	switch t.stack[len(t.stack)-1] {
+	case stateObjStart, stateObjKey, stateObjColon, stateObjValue, stateObjComma:
+		return "object"
+	default:
+		return "array"
+	}
+}
+
+// checkGrammar enforces the JSON grammar from RFC 8259 against tok,
+// using t.stack to track open containers and t.topDone to enforce
+// exactly one top-level value. It is only invoked in strict mode.
+func (t *tokenizer) checkGrammar(tok TokType) error {
+	isValue := tok == TokNull || tok == TokTrue || tok == TokFalse ||
+		tok == TokNumber || tok == TokString ||
+		tok == TokArrayOpen || tok == TokObjectOpen
+
+	if len(t.stack) == 0 {
+		if t.topDone {
+			return fmt.Errorf("unexpected %s after top-level value at byte offset %d", tok, t.offset())
+		}
+		switch tok {
+		case TokObjectClose, TokArrayClose, TokComma, TokObjectColon:
+			return fmt.Errorf("unexpected %s at top level at byte offset %d", tok, t.offset())
+		case TokArrayOpen:
+			t.stack = append(t.stack, stateArrStart)
+			return nil
+		case TokObjectOpen:
+			t.stack = append(t.stack, stateObjStart)
+			return nil
+		}
+		t.topDone = true
+		return nil
+	}
+
+	top := t.stack[len(t.stack)-1]
+
+	switch top {
+	case stateArrStart, stateArrValue:
+		switch {
+		case tok == TokArrayClose && top == stateArrStart:
+			t.stack = t.stack[:len(t.stack)-1]
+		case isValue:
+			t.stack[len(t.stack)-1] = stateArrComma
+			if tok == TokArrayOpen {
+				t.stack = append(t.stack, stateArrStart)
+			} else if tok == TokObjectOpen {
+				t.stack = append(t.stack, stateObjStart)
+			}
+		default:
+			return fmt.Errorf("unexpected %s in array at byte offset %d", tok, t.offset())
+		}
+	case stateArrComma:
+		switch tok {
+		case TokArrayClose:
+			t.stack = t.stack[:len(t.stack)-1]
+		case TokComma:
+			t.stack[len(t.stack)-1] = stateArrValue
+		default:
+			return fmt.Errorf("expected , or ] in array, got %s at byte offset %d", tok, t.offset())
+		}
+	case stateObjStart, stateObjKey:
+		switch {
+		case tok == TokObjectClose && top == stateObjStart:
+			t.stack = t.stack[:len(t.stack)-1]
+		case tok == TokString:
+			t.stack[len(t.stack)-1] = stateObjColon
+		default:
+			return fmt.Errorf("expected string key in object, got %s at byte offset %d", tok, t.offset())
+		}
+	case stateObjColon:
+		if tok != TokObjectColon {
+			return fmt.Errorf("expected : after object key, got %s at byte offset %d", tok, t.offset())
+		}
+		t.stack[len(t.stack)-1] = stateObjValue
+	case stateObjValue:
+		if !isValue {
+			return fmt.Errorf("expected value after : in object, got %s at byte offset %d", tok, t.offset())
+		}
+		t.stack[len(t.stack)-1] = stateObjComma
+		if tok == TokArrayOpen {
+			t.stack = append(t.stack, stateArrStart)
+		} else if tok == TokObjectOpen {
+			t.stack = append(t.stack, stateObjStart)
+		}
+	case stateObjComma:
+		switch tok {
+		case TokObjectClose:
+			t.stack = t.stack[:len(t.stack)-1]
+		case TokComma:
+			t.stack[len(t.stack)-1] = stateObjKey
+		default:
+			return fmt.Errorf("expected , or } in object, got %s at byte offset %d", tok, t.offset())
+		}
+	}
+
+	if len(t.stack) == 0 {
+		t.topDone = true
+	}
+
+	return nil
+}
+
+// SetStrict enables or disables strict grammar validation and resets
+// any in-progress grammar state (container stack and top-level
+// tracking). When enabled, Token enforces the JSON grammar from
+// RFC 8259 in addition to lexing: object keys must be strings
+// followed by ':', values must be comma-separated, trailing commas
+// are rejected, the top level must contain exactly one value, and
+// unbalanced brackets produce an error naming the offending byte.
+func (t *tokenizer) SetStrict(strict bool) {
+	t.strict = strict
+	t.stack = t.stack[:0]
+	t.topDone = false
+}
+
+// Depth returns the current container nesting depth. It is only
+// meaningful when strict mode is enabled.
+func (t *tokenizer) Depth() int {
+	return len(t.stack)
+}
+
+// InObject reports whether the tokenizer is currently positioned
+// inside an object, as opposed to an array or the top level. It is
+// only meaningful when strict mode is enabled.
+func (t *tokenizer) InObject() bool {
+	if len(t.stack) == 0 {
+		return false
+	}
+
+	switch t.stack[len(t.stack)-1] {
+	case stateObjStart, stateObjKey, stateObjColon, stateObjValue, stateObjComma:
+		return true
+	default:
+		return false
+	}
+}
+
 func (t *tokenizer) ReadNumber(into io.Writer) (n int, err error) {
 	for {
 		for i := t.bufp; i < t.bufe; i++ {
@@ -114,7 +417,7 @@ func (t *tokenizer) ReadNumber(into io.Writer) (n int, err error) {
 			z, err := into.Write(t.buf[t.bufp:i])
 			n += z
 			if err != nil {
-				return n, err
+				return n, fmt.Errorf("%w at byte offset %d", err, t.offset())
 			}
 			t.bufp = i
 			return n, nil
@@ -122,18 +425,167 @@ func (t *tokenizer) ReadNumber(into io.Writer) (n int, err error) {
 		z, err := into.Write(t.buf[t.bufp:t.bufe])
 		n += z
 		if err != nil {
-			return n, err
+			return n, fmt.Errorf("%w at byte offset %d", err, t.offset())
 		}
 		err = t.refill()
 		if err == io.EOF {
 			return n, nil
 		}
 		if err != nil {
-			return n, err
+			return n, fmt.Errorf("%w at byte offset %d", err, t.offset())
 		}
 	}
 }
 
+func (t *tokenizer) ReadInt64() (int64, error) {
+	var scratch [defaultSize]byte
+
+	b, isFloat, err := t.readNumberBytes(&scratch)
+	if err != nil {
+		return 0, err
+	}
+	if isFloat {
+		return 0, ErrNotInteger
+	}
+	return parseInt64(b)
+}
+
+func (t *tokenizer) ReadUint64() (uint64, error) {
+	var scratch [defaultSize]byte
+
+	b, isFloat, err := t.readNumberBytes(&scratch)
+	if err != nil {
+		return 0, err
+	}
+	if isFloat {
+		return 0, ErrNotInteger
+	}
+	return parseUint64(b)
+}
+
+func (t *tokenizer) ReadFloat64() (float64, error) {
+	var scratch [defaultSize]byte
+
+	b, _, err := t.readNumberBytes(&scratch)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(string(b), 64)
+}
+
+// readNumberBytes consumes the current number token and returns its
+// raw bytes, along with whether they contain a '.', 'e', or 'E' (i.e.
+// are syntactically a float rather than an integer). When the number
+// fits within the current buffer window the returned slice aliases
+// the internal buffer directly, with no copy; only a number that
+// straddles a refill() is copied into scratch, which must be large
+// enough to hold it.
+func (t *tokenizer) readNumberBytes(scratch *[defaultSize]byte) ([]byte, bool, error) {
+	n := 0
+
+	for {
+		end := t.bufe
+		for i := t.bufp; i < t.bufe; i++ {
+			if lookup[t.buf[i]] != '#' {
+				end = i
+				break
+			}
+		}
+		part := t.buf[t.bufp:end]
+
+		if n == 0 && end < t.bufe {
+			t.bufp = end
+			return part, hasFloatChars(part), nil
+		}
+
+		if n+len(part) > len(scratch) {
+			return nil, false, fmt.Errorf("number exceeds %d-byte limit", len(scratch))
+		}
+		n += copy(scratch[n:], part)
+		t.bufp = end
+
+		if end < t.bufe {
+			return scratch[:n], hasFloatChars(scratch[:n]), nil
+		}
+
+		err := t.refill()
+		if err != nil {
+			if err == io.EOF {
+				return scratch[:n], hasFloatChars(scratch[:n]), nil
+			}
+			return nil, false, err
+		}
+	}
+}
+
+func hasFloatChars(b []byte) bool {
+	for _, c := range b {
+		if c == '.' || c == 'e' || c == 'E' {
+			return true
+		}
+	}
+	return false
+}
+
+func parseInt64(b []byte) (int64, error) {
+	if len(b) == 0 {
+		return 0, fmt.Errorf("invalid integer %q", string(b))
+	}
+
+	neg := false
+	i := 0
+	if b[0] == '-' {
+		neg = true
+		i = 1
+	}
+	if i == len(b) {
+		return 0, fmt.Errorf("invalid integer %q", string(b))
+	}
+
+	var v uint64
+	for ; i < len(b); i++ {
+		c := b[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid integer %q", string(b))
+		}
+		nv := v*10 + uint64(c-'0')
+		if nv < v {
+			return 0, fmt.Errorf("integer %q overflows int64", string(b))
+		}
+		v = nv
+	}
+
+	if neg {
+		if v > 1<<63 {
+			return 0, fmt.Errorf("integer %q overflows int64", string(b))
+		}
+		return -int64(v), nil
+	}
+	if v > math.MaxInt64 {
+		return 0, fmt.Errorf("integer %q overflows int64", string(b))
+	}
+	return int64(v), nil
+}
+
+func parseUint64(b []byte) (uint64, error) {
+	if len(b) == 0 || b[0] == '-' {
+		return 0, fmt.Errorf("invalid unsigned integer %q", string(b))
+	}
+
+	var v uint64
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid unsigned integer %q", string(b))
+		}
+		nv := v*10 + uint64(c-'0')
+		if nv < v {
+			return 0, fmt.Errorf("integer %q overflows uint64", string(b))
+		}
+		v = nv
+	}
+	return v, nil
+}
+
 func (t *tokenizer) ReadString(into io.Writer) (n int, err error) {
 	var prev byte
 
@@ -145,29 +597,235 @@ func (t *tokenizer) ReadString(into io.Writer) (n int, err error) {
 				z, err := into.Write(t.buf[t.bufp : t.bufp+i])
 				n += z
 				t.bufp += i + 1
-				return n, err
+				if err != nil {
+					return n, fmt.Errorf("%w at byte offset %d", err, t.offset())
+				}
+				return n, nil
 			}
 			prev = c
 		}
 		z, err := into.Write(t.buf[t.bufp:t.bufe])
 		n += z
 		if err != nil {
-			return n, err
+			return n, fmt.Errorf("%w at byte offset %d", err, t.offset())
 		}
 		err = t.refill()
-		if err == io.EOF {
-			return n, nil
-		}
 		if err != nil {
+			return n, fmt.Errorf("unexpected %w while reading string at byte offset %d", err, t.offset())
+		}
+	}
+}
+
+func (t *tokenizer) ReadStringUnescaped(into io.Writer) (n int, err error) {
+	var start int
+
+	t.bufp++
+	start = t.bufp
+
+	flush := func(end int) error {
+		if end > start {
+			z, err := into.Write(t.buf[start:end])
+			n += z
+			if err != nil {
+				return err
+			}
+		}
+		start = end
+		return nil
+	}
+
+	for {
+		for t.bufp < t.bufe {
+			c := t.buf[t.bufp]
+			switch {
+			case c == '"':
+				if err := flush(t.bufp); err != nil {
+					return n, err
+				}
+				t.bufp++
+				return n, nil
+			case c == '\\':
+				if err := flush(t.bufp); err != nil {
+					return n, err
+				}
+				t.bufp++
+				z, err := t.readEscape(into)
+				n += z
+				if err != nil {
+					return n, err
+				}
+				start = t.bufp
+			case c < 0x20:
+				return n, fmt.Errorf("invalid control byte %#x in string at byte offset %d", c, t.offset())
+			default:
+				t.bufp++
+			}
+		}
+		if err := flush(t.bufe); err != nil {
 			return n, err
 		}
+		err := t.refill()
+		if err != nil {
+			return n, fmt.Errorf("unexpected %w while reading string at byte offset %d", err, t.offset())
+		}
+		start = t.bufp
+	}
+}
+
+// readEscape decodes a single escape sequence immediately following a
+// backslash already consumed from the buffer, writing the decoded
+// UTF-8 bytes into into and returning how many bytes were written.
+// An invalid escape character is reported at its own byte offset, since
+// by the time it is detected, bufp has already moved past it. Errors for
+// EOF and for \uXXXX escapes (from readEscapedRune and readHex4) carry
+// the offsets those report.
+func (t *tokenizer) readEscape(into io.Writer) (int, error) {
+	pos := t.offset()
+	c, err := t.nextRawByte()
+	if err != nil {
+		return 0, fmt.Errorf("unexpected %w while reading escape sequence at byte offset %d", err, t.offset())
+	}
+
+	switch c {
+	case '"', '\\', '/':
+		return into.Write([]byte{c})
+	case 'b':
+		return into.Write([]byte{'\b'})
+	case 'f':
+		return into.Write([]byte{'\f'})
+	case 'n':
+		return into.Write([]byte{'\n'})
+	case 'r':
+		return into.Write([]byte{'\r'})
+	case 't':
+		return into.Write([]byte{'\t'})
+	case 'u':
+		return t.readEscapedRune(into)
+	default:
+		return 0, fmt.Errorf("invalid escape character %q at byte offset %d", c, pos)
+	}
+}
+
+// readEscapedRune decodes a \uXXXX escape, combining it with a
+// following \uXXXX low surrogate when it is a UTF-16 high surrogate.
+func (t *tokenizer) readEscapedRune(into io.Writer) (int, error) {
+	r1, err := t.readHex4()
+	if err != nil {
+		return 0, err
+	}
+
+	r := rune(r1)
+	if utf16.IsSurrogate(r) {
+		if r1 >= 0xdc00 {
+			return 0, fmt.Errorf("unpaired low surrogate \\u%04x at byte offset %d", r1, t.offset())
+		}
+
+		pos := t.offset()
+		if b, err := t.nextRawByte(); err != nil || b != '\\' {
+			return 0, fmt.Errorf("unpaired high surrogate \\u%04x at byte offset %d", r1, pos)
+		}
+		if b, err := t.nextRawByte(); err != nil || b != 'u' {
+			return 0, fmt.Errorf("unpaired high surrogate \\u%04x at byte offset %d", r1, pos)
+		}
+
+		r2, err := t.readHex4()
+		if err != nil {
+			return 0, err
+		}
+
+		r = utf16.DecodeRune(r, rune(r2))
+		if r == utf8.RuneError {
+			return 0, fmt.Errorf("invalid surrogate pair \\u%04x\\u%04x at byte offset %d", r1, r2, t.offset())
+		}
+	}
+
+	var scratch [utf8.UTFMax]byte
+	z := utf8.EncodeRune(scratch[:], r)
+	return into.Write(scratch[:z])
+}
+
+// readHex4 reads the four hex digits of a \uXXXX escape, refilling the
+// buffer if the digits straddle a refill() boundary.
+func (t *tokenizer) readHex4() (rune, error) {
+	var r rune
+
+	for i := 0; i < 4; i++ {
+		pos := t.offset()
+		c, err := t.nextRawByte()
+		if err != nil {
+			return 0, fmt.Errorf("unexpected %w while reading \\u escape at byte offset %d", err, t.offset())
+		}
+
+		var v rune
+		switch {
+		case c >= '0' && c <= '9':
+			v = rune(c - '0')
+		case c >= 'a' && c <= 'f':
+			v = rune(c-'a') + 10
+		case c >= 'A' && c <= 'F':
+			v = rune(c-'A') + 10
+		default:
+			return 0, fmt.Errorf("invalid hex digit %q in \\u escape at byte offset %d", c, pos)
+		}
+		r = r<<4 | v
+	}
+
+	return r, nil
+}
+
+// nextRawByte returns the next raw byte from the buffer, refilling it
+// if necessary, without interpreting it.
+func (t *tokenizer) nextRawByte() (byte, error) {
+	if t.bufp >= t.bufe {
+		if err := t.refill(); err != nil {
+			return 0, err
+		}
+	}
+
+	c := t.buf[t.bufp]
+	t.bufp++
+	return c, nil
+}
+
+func (t *tokenizer) Skip() error {
+	depth := 0
+
+	for {
+		tok, err := t.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case TokString:
+			if _, err := t.ReadString(io.Discard); err != nil {
+				return err
+			}
+		case TokNumber:
+			if _, err := t.ReadNumber(io.Discard); err != nil {
+				return err
+			}
+		case TokArrayOpen, TokObjectOpen:
+			depth++
+			continue
+		case TokArrayClose, TokObjectClose:
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
 	}
 }
 
 func (t *tokenizer) Reset(in io.Reader) {
 	t.bufp = 0
 	t.bufe = 0
+	t.consumed = 0
 	t.in = in
+	t.strict = false
+	t.stack = t.stack[:0]
+	t.topDone = false
 }
 
 func (t *tokenizer) readWord(w []byte) error {
@@ -176,14 +834,14 @@ func (t *tokenizer) readWord(w []byte) error {
 			err := t.refill()
 			if err != nil {
 				if err == io.EOF {
-					return fmt.Errorf("expected %s got EOF", w)
+					return fmt.Errorf("expected %s got EOF at byte offset %d", w, t.offset())
 				}
-				return err
+				return fmt.Errorf("%w at byte offset %d", err, t.offset())
 			}
 		}
 
 		if t.buf[t.bufp] != c {
-			return fmt.Errorf("expected %s got %c at index %d", w, t.buf[t.bufp], i)
+			return fmt.Errorf("expected %s got %c at index %d, byte offset %d", w, t.buf[t.bufp], i, t.offset())
 		}
 		t.bufp++
 	}
@@ -210,8 +868,15 @@ func (t *tokenizer) peek() (byte, error) {
 }
 
 func (t *tokenizer) refill() (err error) {
+	t.consumed += int64(t.bufe)
 	t.bufp = 0
 	t.bufe, err = t.in.Read(t.buf)
 
 	return err
 }
+
+// offset returns the number of bytes consumed from the underlying
+// reader up to (but not including) t.buf[t.bufp].
+func (t *tokenizer) offset() int64 {
+	return t.consumed + int64(t.bufp)
+}
@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"runtime"
 	"testing"
@@ -104,10 +106,10 @@ func TestToken_Bad(t *testing.T) {
 		in  string
 		err string
 	}{
-		{"nil", "expected null got i at index 1"},
-		{"hi", `invalid json "hi"`},
-		{" fall", "expected false got l at index 3"},
-		{" f", "expected false got EOF"},
+		{"nil", "expected null got i at index 1, byte offset 1"},
+		{"hi", `invalid json "hi" at byte offset 0`},
+		{" fall", "expected false got l at index 3, byte offset 4"},
+		{" f", "expected false got EOF at byte offset 2"},
 	}
 	for _, tt := range tts {
 		t.Run(tt.in, func(t *testing.T) {
@@ -122,6 +124,383 @@ func TestToken_Bad(t *testing.T) {
 	}
 }
 
+func TestReadStringUnescaped(t *testing.T) {
+	tts := []struct {
+		in  string
+		out string
+	}{
+		{`"hi"`, "hi"},
+		{`"1\"4"`, `1"4`},
+		{`"\\\/\b\f\n\r\t"`, "\\/\b\f\n\r\t"},
+		{`"Aé"`, "Aé"},
+		{`"😀"`, "\U0001F600"},
+		{`"a loooooooooong \n string"`, "a loooooooooong \n string"},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is  = is.New(t)
+				tk  = NewWithSize(bytes.NewBufferString(tt.in), 7)
+				buf bytes.Buffer
+			)
+			tok, err := tk.Token()
+			is.NoErr(err)
+			is.Equal(tok, TokString)
+			_, err = tk.ReadStringUnescaped(&buf)
+			is.NoErr(err)
+			is.Equal(buf.String(), tt.out)
+		})
+	}
+}
+
+func TestReadStringUnescaped_Bad(t *testing.T) {
+	tts := []struct {
+		in  string
+		err string
+	}{
+		{`"\x"`, `invalid escape character 'x' at byte offset 2`},
+		{`"\ud83d"`, `unpaired high surrogate \ud83d at byte offset 7`},
+		{`"\ude00"`, `unpaired low surrogate \ude00 at byte offset 7`},
+		{"\"\x01\"", `invalid control byte 0x1 in string at byte offset 1`},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is  = is.New(t)
+				tk  = New(bytes.NewBufferString(tt.in))
+				buf bytes.Buffer
+			)
+			_, err := tk.Token()
+			is.NoErr(err)
+			_, err = tk.ReadStringUnescaped(&buf)
+			is.True(err != nil)
+			is.Equal(err.Error(), tt.err)
+		})
+	}
+}
+
+func TestReadString_TruncatedEOF(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = New(bytes.NewBufferString(`"hello`))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokString)
+	_, err = tk.ReadString(io.Discard)
+	is.True(err != nil)
+	is.True(errors.Is(err, io.EOF))
+}
+
+func TestSkip(t *testing.T) {
+	tts := []struct {
+		in   string
+		rest []TokType
+	}{
+		{"null, true", []TokType{TokComma, TokTrue}},
+		{"42, true", []TokType{TokComma, TokTrue}},
+		{`"hi\"there", true`, []TokType{TokComma, TokTrue}},
+		{`{"a":[1,2,{"b":"c"}]}, true`, []TokType{TokComma, TokTrue}},
+		{`[1,"[",{"}":"]"},[2]], true`, []TokType{TokComma, TokTrue}},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is   = is.New(t)
+				tk   = NewWithSize(bytes.NewBufferString(tt.in), 7)
+				toks []TokType
+			)
+			is.NoErr(tk.Skip())
+			for {
+				tok, err := tk.Token()
+				if err == io.EOF {
+					break
+				}
+				is.NoErr(err)
+				toks = append(toks, tok)
+			}
+			is.Equal(toks, tt.rest)
+		})
+	}
+}
+
+func TestStrict(t *testing.T) {
+	tts := []string{
+		`{"a":1,"b":[1,2,{"c":true}]}`,
+		`[]`,
+		`{}`,
+		`[1,2,3]`,
+		`null`,
+		`"hi"`,
+	}
+	for _, in := range tts {
+		t.Run(in, func(t *testing.T) {
+			var (
+				is = is.New(t)
+				tk = NewStrict(bytes.NewBufferString(in))
+			)
+			for {
+				tok, err := tk.Token()
+				if err == io.EOF {
+					break
+				}
+				is.NoErr(err)
+				switch tok {
+				case TokString:
+					_, err = tk.ReadString(io.Discard)
+					is.NoErr(err)
+				case TokNumber:
+					_, err = tk.ReadNumber(io.Discard)
+					is.NoErr(err)
+				}
+			}
+			is.Equal(tk.Depth(), 0)
+		})
+	}
+}
+
+func TestStrict_Bad(t *testing.T) {
+	tts := []struct {
+		in  string
+		err string
+	}{
+		{`{"a":1,}`, "expected string key in object, got } at byte offset 8"},
+		{`[1,2,]`, "unexpected ] in array at byte offset 6"},
+		{`{"a" 1}`, "expected : after object key, got number at byte offset 5"},
+		{`{"a":1 "b":2}`, "expected , or } in object, got string at byte offset 7"},
+		{`1 2`, "unexpected number after top-level value at byte offset 2"},
+		{`]`, "unexpected ] at top level at byte offset 1"},
+		{`{]}`, "expected string key in object, got ] at byte offset 2"},
+		{`{"a":1`, "unexpected EOF with unclosed object at byte offset 6"},
+		{`[1,2`, "unexpected EOF with unclosed array at byte offset 4"},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is = is.New(t)
+				tk = NewStrict(bytes.NewBufferString(tt.in))
+			)
+			for {
+				tok, err := tk.Token()
+				if err != nil {
+					is.Equal(err.Error(), tt.err)
+					return
+				}
+				switch tok {
+				case TokString:
+					_, _ = tk.ReadString(io.Discard)
+				case TokNumber:
+					_, _ = tk.ReadNumber(io.Discard)
+				}
+			}
+		})
+	}
+}
+
+func TestStrict_InObject(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = NewStrict(bytes.NewBufferString(`{"a":[1,2]}`))
+	)
+	tok, err := tk.Token() // {
+	is.NoErr(err)
+	is.Equal(tok, TokObjectOpen)
+	is.True(tk.InObject())
+	is.Equal(tk.Depth(), 1)
+
+	tok, err = tk.Token() // "a"
+	is.NoErr(err)
+	is.Equal(tok, TokString)
+	_, err = tk.ReadString(io.Discard)
+	is.NoErr(err)
+
+	tok, err = tk.Token() // :
+	is.NoErr(err)
+	is.Equal(tok, TokObjectColon)
+
+	tok, err = tk.Token() // [
+	is.NoErr(err)
+	is.Equal(tok, TokArrayOpen)
+	is.True(!tk.InObject())
+	is.Equal(tk.Depth(), 2)
+}
+
+func TestReadInt64(t *testing.T) {
+	tts := []struct {
+		in  string
+		out int64
+	}{
+		{"0", 0},
+		{"-122", -122},
+		{"9223372036854775807", math.MaxInt64},
+		{"-9223372036854775808", math.MinInt64},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is = is.New(t)
+				tk = NewWithSize(bytes.NewBufferString(tt.in), 7)
+			)
+			tok, err := tk.Token()
+			is.NoErr(err)
+			is.Equal(tok, TokNumber)
+			v, err := tk.ReadInt64()
+			is.NoErr(err)
+			is.Equal(v, tt.out)
+		})
+	}
+}
+
+func TestReadUint64(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = NewWithSize(bytes.NewBufferString("18446744073709551615"), 7)
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNumber)
+	v, err := tk.ReadUint64()
+	is.NoErr(err)
+	is.Equal(v, uint64(math.MaxUint64))
+}
+
+func TestReadFloat64(t *testing.T) {
+	tts := []struct {
+		in  string
+		out float64
+	}{
+		{"42", 42},
+		{"-1.5", -1.5},
+		{"1111111111E+4", 1111111111e4},
+	}
+	for _, tt := range tts {
+		t.Run(tt.in, func(t *testing.T) {
+			var (
+				is = is.New(t)
+				tk = NewWithSize(bytes.NewBufferString(tt.in), 7)
+			)
+			tok, err := tk.Token()
+			is.NoErr(err)
+			is.Equal(tok, TokNumber)
+			v, err := tk.ReadFloat64()
+			is.NoErr(err)
+			is.Equal(v, tt.out)
+		})
+	}
+}
+
+func TestReadInt64_Overflow(t *testing.T) {
+	tts := []string{
+		"99999999999999999999999999999999999999",
+		"18446744073709551616",
+		"9223372036854775808",
+	}
+	for _, tt := range tts {
+		t.Run(tt, func(t *testing.T) {
+			var (
+				is = is.New(t)
+				tk = New(bytes.NewBufferString(tt))
+			)
+			tok, err := tk.Token()
+			is.NoErr(err)
+			is.Equal(tok, TokNumber)
+			_, err = tk.ReadInt64()
+			is.True(err != nil)
+		})
+	}
+}
+
+func TestReadInt64_NotInteger(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = New(bytes.NewBufferString("1.5"))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNumber)
+	_, err = tk.ReadInt64()
+	is.Equal(err, ErrNotInteger)
+}
+
+func TestReadUint64_Negative(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = New(bytes.NewBufferString("-122"))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNumber)
+	_, err = tk.ReadUint64()
+	is.True(err != nil)
+}
+
+func TestReadUint64_NotInteger(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = New(bytes.NewBufferString("1.5"))
+	)
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, TokNumber)
+	_, err = tk.ReadUint64()
+	is.Equal(err, ErrNotInteger)
+}
+
+func TestPeek(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = NewWithSize(bytes.NewBufferString(`{"a":1}`), 7)
+	)
+
+	peeked, err := tk.Peek()
+	is.NoErr(err)
+	is.Equal(peeked, TokObjectOpen)
+
+	tok, err := tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, peeked)
+
+	peeked, err = tk.Peek()
+	is.NoErr(err)
+	is.Equal(peeked, TokString)
+
+	tok, err = tk.Token()
+	is.NoErr(err)
+	is.Equal(tok, peeked)
+	_, err = tk.ReadString(io.Discard)
+	is.NoErr(err)
+
+	peeked, err = tk.Peek()
+	is.NoErr(err)
+	is.Equal(peeked, TokObjectColon)
+}
+
+func TestOffset(t *testing.T) {
+	var (
+		is = is.New(t)
+		tk = NewWithSize(bytes.NewBufferString(`{"ab":12}`), 4)
+	)
+	is.Equal(tk.Offset(), int64(0))
+
+	tok, err := tk.Token() // {
+	is.NoErr(err)
+	is.Equal(tok, TokObjectOpen)
+	is.Equal(tk.Offset(), int64(1))
+
+	tok, err = tk.Token() // "ab"
+	is.NoErr(err)
+	is.Equal(tok, TokString)
+	is.Equal(tk.Offset(), int64(1))
+	_, err = tk.ReadString(io.Discard)
+	is.NoErr(err)
+	is.Equal(tk.Offset(), int64(5))
+
+	tok, err = tk.Token() // :
+	is.NoErr(err)
+	is.Equal(tok, TokObjectColon)
+	is.Equal(tk.Offset(), int64(6))
+}
+
 func TestReadNumber_Limited(t *testing.T) {
 	var (
 		is  = is.New(t)